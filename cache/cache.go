@@ -0,0 +1,149 @@
+// Package cache provides pluggable cache backends (in-memory LRU, Redis, ...)
+// behind a single Cache interface.
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrKeyNotFound is returned by Get/Delete when the key isn't present (or has expired).
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrUnsupported is returned by optional capabilities (e.g. Keyser) when the
+// backend doesn't implement them.
+var ErrUnsupported = errors.New("cache: operation not supported by this backend")
+
+// ErrLockHeld is returned by TryLock when the lock is already held.
+var ErrLockHeld = errors.New("cache: lock already held")
+
+// Keyser is an optional capability implemented by backends that can
+// enumerate every key they currently hold.
+type Keyser interface {
+	Keys() ([]string, error)
+}
+
+// Unlocker releases a lock acquired via Cache.Lock/TryLock.
+type Unlocker interface {
+	Unlock(ctx context.Context) error
+
+	// Token returns the value identifying this held lock. A caller that
+	// needs to release the lock from somewhere other than the Unlocker
+	// instance itself (e.g. a different replica handling the HTTP
+	// request that releases it) can hand the key and this token to
+	// Cache.ReleaseLock instead.
+	Token() string
+}
+
+// Cache defines the unified cache interface implemented by every backend.
+type Cache interface {
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	Get(ctx context.Context, key string) (interface{}, error)
+	Delete(ctx context.Context, key string) error
+	AsyncSet(ctx context.Context, key string, value interface{}, ttl time.Duration)
+	AsyncDelete(ctx context.Context, key string)
+
+	// GetBytes returns the raw encoded bytes backing key, without decoding
+	// them into an interface{}.
+	GetBytes(ctx context.Context, key string) ([]byte, error)
+	// GetInto decodes the value stored at key into dst, which must be a
+	// pointer.
+	GetInto(ctx context.Context, key string, dst interface{}) error
+
+	// AddToSet adds members to the set stored at key.
+	AddToSet(ctx context.Context, key string, members ...string) error
+	// IsInSet reports whether member belongs to the set stored at key.
+	IsInSet(ctx context.Context, key string, member string) (bool, error)
+	// DeleteFromSet removes members from the set stored at key.
+	DeleteFromSet(ctx context.Context, key string, members ...string) error
+
+	// GetFromHash returns the value of field in the hash stored at key.
+	GetFromHash(ctx context.Context, key string, field string) (interface{}, error)
+	// SetToHash sets field to value in the hash stored at key.
+	SetToHash(ctx context.Context, key string, field string, value interface{}) error
+	// DeleteFromHash removes fields from the hash stored at key.
+	DeleteFromHash(ctx context.Context, key string, fields ...string) error
+
+	// Lock blocks until it acquires the distributed lock named key, or ctx
+	// is done. ttl bounds how long the lock is held if the caller never
+	// calls Unlock (e.g. it crashes).
+	Lock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error)
+	// TryLock attempts to acquire the lock named key without blocking. It
+	// returns ErrLockHeld if another caller already holds it.
+	TryLock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error)
+	// ReleaseLock releases the lock named key if it is still held by
+	// token, regardless of which Unlocker instance (if any, in this
+	// process) originally acquired it. This is what lets a lock acquired
+	// by one replica be released by a request that lands on another.
+	ReleaseLock(ctx context.Context, key, token string) error
+}
+
+// RedisCache implements Cache using Redis. client is a redis.UniversalClient
+// rather than a concrete *redis.Client so RedisCache transparently works
+// against a standalone instance, a Sentinel-fronted master, or a Cluster
+// (see NewRedisCacheFromConfig).
+type RedisCache struct {
+	client redis.UniversalClient
+}
+
+// NewRedisCache creates a new RedisCache instance around an existing client.
+func NewRedisCache(client redis.UniversalClient) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+// Set adds or updates a key-value pair in Redis with the specified TTL.
+func (c *RedisCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Get retrieves the value associated with the given key from Redis.
+func (c *RedisCache) Get(ctx context.Context, key string) (interface{}, error) {
+	value, err := c.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	return value, err
+}
+
+// Delete removes the entry associated with the given key from Redis.
+func (c *RedisCache) Delete(ctx context.Context, key string) error {
+	deleted, err := c.client.Del(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// AsyncSet asynchronously sets a key-value pair in Redis.
+func (c *RedisCache) AsyncSet(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	go func() {
+		_ = c.Set(ctx, key, value, ttl)
+	}()
+}
+
+// AsyncDelete asynchronously deletes a key from Redis.
+func (c *RedisCache) AsyncDelete(ctx context.Context, key string) {
+	go func() {
+		_ = c.Delete(ctx, key)
+	}()
+}
+
+// Keys returns every key currently stored in Redis, enumerated via SCAN so
+// large keyspaces don't block the server the way KEYS * would. Against a
+// Cluster the SCAN is sent to a single node, so only that node's keys are
+// returned.
+func (c *RedisCache) Keys() ([]string, error) {
+	ctx := context.Background()
+	var keys []string
+	iter := c.client.Scan(ctx, 0, "*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
+}
@@ -0,0 +1,368 @@
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// numShards is the number of independent shards InMemoryCache splits its
+// keyspace across. Each shard has its own lock and LRU list, so goroutines
+// touching different shards never contend with each other.
+const numShards = 16
+
+// InMemoryCache implements Cache using a sharded in-memory map with LRU
+// eviction per shard.
+type InMemoryCache struct {
+	shards [numShards]*shard
+
+	gcInterval        time.Duration
+	done              chan struct{}
+	expiredCount      int64
+	lastSweepDuration int64 // time.Duration, accessed atomically
+
+	locksMu sync.Mutex
+	locks   map[string]*lockEntry
+}
+
+// shard owns one slice of the keyspace: its own lock, LRU list, and a pool
+// of preallocated nodes so Set doesn't allocate list nodes on the hot path.
+type shard struct {
+	mu      sync.Mutex
+	maxSize int
+	cache   map[string]*entry
+	lruList *list
+	free    []*node
+
+	sets   map[string]map[string]struct{}
+	hashes map[string]map[string][]byte
+}
+
+// entry represents a key-value pair in the in-memory cache. It holds a
+// direct pointer to its list node so moveToFront/remove can relink that
+// node in place instead of allocating a throwaway one.
+type entry struct {
+	key   string
+	value interface{}
+	ttl   time.Time
+	node  *node
+}
+
+// list implements an intrusive doubly linked list for LRU eviction: nodes
+// are owned by the shard's node pool, not allocated per operation.
+type list struct {
+	head *node
+	tail *node
+}
+
+// node is a link in the LRU list. entr is nil while the node sits in a
+// shard's free pool.
+type node struct {
+	prev *node
+	next *node
+	entr *entry
+}
+
+// NewInMemoryCache creates a new instance of InMemoryCache and, if
+// gcInterval > 0, starts its background GC goroutine, which sweeps expired
+// entries every gcInterval.
+// Without this, entries only expire lazily on Get, so stale entries can
+// occupy LRU slots indefinitely and force eviction of still-live keys.
+// Callers must call Close when done with the cache to stop the goroutine.
+func NewInMemoryCache(maxSize int, gcInterval time.Duration) *InMemoryCache {
+	perShard := maxSize / numShards
+	if perShard < 1 {
+		perShard = 1
+	}
+
+	c := &InMemoryCache{
+		gcInterval: gcInterval,
+		done:       make(chan struct{}),
+		locks:      make(map[string]*lockEntry),
+	}
+	for i := range c.shards {
+		free := make([]*node, perShard)
+		for j := range free {
+			free[j] = &node{}
+		}
+		c.shards[i] = &shard{
+			maxSize: perShard,
+			cache:   make(map[string]*entry),
+			lruList: &list{},
+			free:    free,
+			sets:    make(map[string]map[string]struct{}),
+			hashes:  make(map[string]map[string][]byte),
+		}
+	}
+	if gcInterval > 0 {
+		go c.runGC()
+	}
+	return c
+}
+
+// fnv32 hashes key with FNV-1a to pick its shard.
+func fnv32(key string) uint32 {
+	const (
+		offsetBasis uint32 = 2166136261
+		prime       uint32 = 16777619
+	)
+	h := offsetBasis
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= prime
+	}
+	return h
+}
+
+func (c *InMemoryCache) shardFor(key string) *shard {
+	return c.shards[fnv32(key)%numShards]
+}
+
+// allocNode returns a node from the shard's free pool, or a new one if the
+// pool is empty. The pool starts with maxSize nodes and removeEntry returns
+// nodes to it, so Set never allocates a list node; it still allocates an
+// entry for each key it inserts.
+func (s *shard) allocNode() *node {
+	if n := len(s.free); n > 0 {
+		node := s.free[n-1]
+		s.free = s.free[:n-1]
+		return node
+	}
+	return &node{}
+}
+
+// freeNode clears n and returns it to the shard's pool for reuse.
+func (s *shard) freeNode(n *node) {
+	n.prev, n.next, n.entr = nil, nil, nil
+	s.free = append(s.free, n)
+}
+
+// Set adds or updates a key-value pair in the cache and handles LRU eviction.
+func (c *InMemoryCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// If the key already exists, update the value and TTL, and move it to the front.
+	if ent, exists := s.cache[key]; exists {
+		ent.value = value
+		ent.ttl = time.Now().Add(ttl)
+		s.lruList.moveToFront(ent.node)
+		return nil
+	}
+
+	// If the shard is at its maximum size, evict its least recently used element.
+	if len(s.cache) >= s.maxSize {
+		s.evict()
+	}
+
+	n := s.allocNode()
+	ent := &entry{key: key, value: value, ttl: time.Now().Add(ttl), node: n}
+	n.entr = ent
+	s.lruList.pushFront(n)
+	s.cache[key] = ent
+
+	return nil
+}
+
+// Get retrieves the value for a key from the cache and updates its position in the LRU list.
+func (c *InMemoryCache) Get(ctx context.Context, key string) (interface{}, error) {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ent, exists := s.cache[key]; exists {
+		if ent.ttl.After(time.Now()) {
+			s.lruList.moveToFront(ent.node)
+			return ent.value, nil
+		}
+		s.removeEntry(ent)
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// Delete removes a key from the cache.
+func (c *InMemoryCache) Delete(ctx context.Context, key string) error {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ent, exists := s.cache[key]; exists {
+		s.removeEntry(ent)
+		return nil
+	}
+
+	return ErrKeyNotFound
+}
+
+// AsyncSet asynchronously sets a key-value pair in the cache.
+func (c *InMemoryCache) AsyncSet(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	go func() {
+		_ = c.Set(ctx, key, value, ttl)
+	}()
+}
+
+// AsyncDelete asynchronously deletes a key from the cache.
+func (c *InMemoryCache) AsyncDelete(ctx context.Context, key string) {
+	go func() {
+		_ = c.Delete(ctx, key)
+	}()
+}
+
+// Keys returns every non-expired key currently stored in the cache.
+func (c *InMemoryCache) Keys() ([]string, error) {
+	var keys []string
+	now := time.Now()
+	for _, s := range c.shards {
+		s.mu.Lock()
+		for key, ent := range s.cache {
+			if ent.ttl.After(now) {
+				keys = append(keys, key)
+			}
+		}
+		s.mu.Unlock()
+	}
+	return keys, nil
+}
+
+// removeEntry removes an entry from its shard's map and LRU list, returning
+// its node to the free pool.
+func (s *shard) removeEntry(ent *entry) {
+	s.lruList.remove(ent.node)
+	delete(s.cache, ent.key)
+	s.freeNode(ent.node)
+}
+
+// evict removes the least recently used entry from the shard.
+func (s *shard) evict() {
+	n := s.lruList.back()
+	if n != nil {
+		s.removeEntry(n.entr)
+	}
+}
+
+// list methods for LRU operations. All of them operate on nodes that
+// already belong to the shard's pool/list, so none of them allocate.
+
+// pushFront attaches n at the front of the list.
+func (l *list) pushFront(n *node) {
+	if l.head == nil {
+		l.head = n
+		l.tail = n
+		return
+	}
+	n.next = l.head
+	l.head.prev = n
+	l.head = n
+}
+
+// moveToFront relinks n to the front of the list in place.
+func (l *list) moveToFront(n *node) {
+	if n == l.head {
+		return
+	}
+	l.unlink(n)
+	l.pushFront(n)
+}
+
+// remove unlinks n from the list.
+func (l *list) remove(n *node) {
+	l.unlink(n)
+}
+
+// unlink detaches n from its neighbors, patching head/tail as needed.
+func (l *list) unlink(n *node) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev, n.next = nil, nil
+}
+
+// back returns the last node in the list.
+func (l *list) back() *node {
+	return l.tail
+}
+
+// runGC sweeps expired entries every gcInterval until Close is called. A
+// startup jitter of up to 10% of gcInterval spreads the first sweep across
+// many InMemoryCache instances so they don't all tick in lockstep.
+func (c *InMemoryCache) runGC() {
+	jitter := time.Duration(rand.Int63n(int64(c.gcInterval)/10 + 1))
+
+	timer := time.NewTimer(jitter)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-c.done:
+		return
+	}
+
+	ticker := time.NewTicker(c.gcInterval)
+	defer ticker.Stop()
+
+	c.sweep()
+	for {
+		select {
+		case <-ticker.C:
+			c.sweep()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// sweep removes every expired entry from each shard's LRU list.
+func (c *InMemoryCache) sweep() {
+	start := time.Now()
+	now := time.Now()
+
+	var expiredCount int
+	for _, s := range c.shards {
+		s.mu.Lock()
+		var expired []*entry
+		for n := s.lruList.head; n != nil; n = n.next {
+			if n.entr.ttl.Before(now) {
+				expired = append(expired, n.entr)
+			}
+		}
+		for _, ent := range expired {
+			s.removeEntry(ent)
+		}
+		s.mu.Unlock()
+		expiredCount += len(expired)
+	}
+
+	atomic.AddInt64(&c.expiredCount, int64(expiredCount))
+	atomic.StoreInt64(&c.lastSweepDuration, int64(time.Since(start)))
+}
+
+// ExpiredCount returns the total number of entries the GC has removed.
+func (c *InMemoryCache) ExpiredCount() int64 {
+	return atomic.LoadInt64(&c.expiredCount)
+}
+
+// LastSweepDuration returns how long the most recent GC sweep took.
+func (c *InMemoryCache) LastSweepDuration() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.lastSweepDuration))
+}
+
+// Close stops the background GC goroutine. It is safe to call more than once.
+func (c *InMemoryCache) Close() error {
+	select {
+	case <-c.done:
+	default:
+		close(c.done)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func BenchmarkInMemoryCacheSet(b *testing.B) {
+	c := NewInMemoryCache(10000, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Set(ctx, strconv.Itoa(i%10000), i, time.Minute)
+	}
+}
+
+func BenchmarkInMemoryCacheGetHit(b *testing.B) {
+	c := NewInMemoryCache(10000, 0)
+	defer c.Close()
+	ctx := context.Background()
+	for i := 0; i < 10000; i++ {
+		c.Set(ctx, strconv.Itoa(i), i, time.Minute)
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Get(ctx, strconv.Itoa(i%10000))
+	}
+}
+
+func BenchmarkInMemoryCacheParallel(b *testing.B) {
+	c := NewInMemoryCache(10000, 0)
+	defer c.Close()
+	ctx := context.Background()
+	for i := 0; i < 10000; i++ {
+		c.Set(ctx, strconv.Itoa(i), i, time.Minute)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := strconv.Itoa(i % 10000)
+			c.Get(ctx, key)
+			i++
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestInMemoryCacheGCSweepsExpiredEntries(t *testing.T) {
+	c := NewInMemoryCache(10, 10*time.Millisecond)
+	defer c.Close()
+	ctx := context.Background()
+
+	if err := c.Set(ctx, "k", "v", 5*time.Millisecond); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for c.ExpiredCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if got := c.ExpiredCount(); got == 0 {
+		t.Fatalf("ExpiredCount() = 0, want > 0 after the entry's ttl and a GC sweep elapsed")
+	}
+
+	s := c.shardFor("k")
+	s.mu.Lock()
+	_, stillPresent := s.cache["k"]
+	s.mu.Unlock()
+	if stillPresent {
+		t.Fatalf("key still present in shard map after GC sweep")
+	}
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// lockEntry tracks who currently holds a named lock and until when. An
+// entry whose ttl has elapsed is treated as released, so TryLock can
+// steal it from a caller that crashed (or otherwise never called Unlock)
+// instead of holding the key forever.
+type lockEntry struct {
+	token   string
+	expires time.Time
+}
+
+// inMemoryLock is the Unlocker returned by InMemoryCache.Lock/TryLock.
+type inMemoryLock struct {
+	cache *InMemoryCache
+	key   string
+	token string
+}
+
+func newLockToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Lock blocks until it acquires the in-memory lock named key, or ctx is done.
+func (c *InMemoryCache) Lock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	const retryInterval = 50 * time.Millisecond
+
+	for {
+		lock, err := c.TryLock(ctx, key, ttl)
+		if err == nil {
+			return lock, nil
+		}
+		if err != ErrLockHeld {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// TryLock attempts to acquire the in-memory lock named key without
+// blocking. It returns ErrLockHeld only if another holder's ttl hasn't
+// yet elapsed; an expired holder is replaced.
+func (c *InMemoryCache) TryLock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	c.locksMu.Lock()
+	defer c.locksMu.Unlock()
+
+	if existing, held := c.locks[key]; held && existing.expires.After(time.Now()) {
+		return nil, ErrLockHeld
+	}
+
+	c.locks[key] = &lockEntry{token: token, expires: time.Now().Add(ttl)}
+	return &inMemoryLock{cache: c, key: key, token: token}, nil
+}
+
+// Unlock releases the lock, but only if it's still held by this token (an
+// expired lock may already have been taken over by another caller).
+func (l *inMemoryLock) Unlock(ctx context.Context) error {
+	return l.cache.ReleaseLock(ctx, l.key, l.token)
+}
+
+// Token returns the token identifying this held lock.
+func (l *inMemoryLock) Token() string {
+	return l.token
+}
+
+// ReleaseLock releases the in-memory lock named key if it is still held
+// by token, independent of which inMemoryLock (if any) originally
+// acquired it.
+func (c *InMemoryCache) ReleaseLock(ctx context.Context, key, token string) error {
+	c.locksMu.Lock()
+	defer c.locksMu.Unlock()
+
+	if existing, held := c.locks[key]; held && existing.token == token {
+		delete(c.locks, key)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestInMemoryCacheTryLockHeld(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("first TryLock: %v", err)
+	}
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != ErrLockHeld {
+		t.Fatalf("second TryLock = %v, want ErrLockHeld", err)
+	}
+}
+
+func TestInMemoryCacheTryLockExpires(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if _, err := c.TryLock(ctx, "k", 10*time.Millisecond); err != nil {
+		t.Fatalf("first TryLock: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// The first holder never called Unlock (e.g. it crashed), but its
+	// ttl has elapsed, so a new caller must be able to take over.
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("TryLock after expiry = %v, want nil", err)
+	}
+}
+
+func TestInMemoryCacheUnlockThenReacquire(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	lock, err := c.TryLock(ctx, "k", time.Minute)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	if err := lock.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("TryLock after Unlock = %v, want nil", err)
+	}
+}
+
+func TestInMemoryCacheLocksArePerInstance(t *testing.T) {
+	a := NewInMemoryCache(10, 0)
+	defer a.Close()
+	b := NewInMemoryCache(10, 0)
+	defer b.Close()
+	ctx := context.Background()
+
+	if _, err := a.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("TryLock on a: %v", err)
+	}
+	if _, err := b.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("TryLock on b = %v, want nil (locks must not be shared across instances)", err)
+	}
+}
+
+func TestInMemoryCacheReleaseLockByToken(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	lock, err := c.TryLock(ctx, "k", time.Minute)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+
+	if err := c.ReleaseLock(ctx, "k", "wrong-token"); err != nil {
+		t.Fatalf("ReleaseLock with wrong token: %v", err)
+	}
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != ErrLockHeld {
+		t.Fatalf("TryLock after ReleaseLock with wrong token = %v, want ErrLockHeld", err)
+	}
+
+	if err := c.ReleaseLock(ctx, "k", lock.Token()); err != nil {
+		t.Fatalf("ReleaseLock: %v", err)
+	}
+	if _, err := c.TryLock(ctx, "k", time.Minute); err != nil {
+		t.Fatalf("TryLock after ReleaseLock = %v, want nil", err)
+	}
+}
@@ -0,0 +1,152 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+)
+
+// GetBytes returns the gob-encoded bytes of the value stored at key.
+func (c *InMemoryCache) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, fmt.Errorf("cache: failed to encode value: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GetInto decodes the value stored at key into dst via gob.
+func (c *InMemoryCache) GetInto(ctx context.Context, key string, dst interface{}) error {
+	value, err := c.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return gobAssign(dst, value)
+}
+
+// gobAssign round-trips value through gob so it can be decoded into the
+// concrete type dst points at.
+func gobAssign(dst interface{}, value interface{}) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return fmt.Errorf("cache: failed to encode value: %w", err)
+	}
+	return gob.NewDecoder(&buf).Decode(dst)
+}
+
+// AddToSet adds members to the set stored at key.
+func (c *InMemoryCache) AddToSet(ctx context.Context, key string, members ...string) error {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, exists := s.sets[key]
+	if !exists {
+		set = make(map[string]struct{})
+		s.sets[key] = set
+	}
+	for _, member := range members {
+		set[member] = struct{}{}
+	}
+	return nil
+}
+
+// IsInSet reports whether member belongs to the set stored at key.
+func (c *InMemoryCache) IsInSet(ctx context.Context, key string, member string) (bool, error) {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, exists := s.sets[key]
+	if !exists {
+		return false, nil
+	}
+	_, isMember := set[member]
+	return isMember, nil
+}
+
+// DeleteFromSet removes members from the set stored at key.
+func (c *InMemoryCache) DeleteFromSet(ctx context.Context, key string, members ...string) error {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, exists := s.sets[key]
+	if !exists {
+		return nil
+	}
+	for _, member := range members {
+		delete(set, member)
+	}
+	if len(set) == 0 {
+		delete(s.sets, key)
+	}
+	return nil
+}
+
+// GetFromHash returns the value of field in the hash stored at key.
+func (c *InMemoryCache) GetFromHash(ctx context.Context, key string, field string) (interface{}, error) {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, exists := s.hashes[key]
+	if !exists {
+		return nil, ErrKeyNotFound
+	}
+	data, exists := hash[field]
+	if !exists {
+		return nil, ErrKeyNotFound
+	}
+
+	var value interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value); err != nil {
+		return nil, fmt.Errorf("cache: failed to decode hash field: %w", err)
+	}
+	return value, nil
+}
+
+// SetToHash sets field to value in the hash stored at key.
+func (c *InMemoryCache) SetToHash(ctx context.Context, key string, field string, value interface{}) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+		return fmt.Errorf("cache: failed to encode hash field: %w", err)
+	}
+
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, exists := s.hashes[key]
+	if !exists {
+		hash = make(map[string][]byte)
+		s.hashes[key] = hash
+	}
+	hash[field] = buf.Bytes()
+	return nil
+}
+
+// DeleteFromHash removes fields from the hash stored at key.
+func (c *InMemoryCache) DeleteFromHash(ctx context.Context, key string, fields ...string) error {
+	s := c.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, exists := s.hashes[key]
+	if !exists {
+		return nil
+	}
+	for _, field := range fields {
+		delete(hash, field)
+	}
+	if len(hash) == 0 {
+		delete(s.hashes, key)
+	}
+	return nil
+}
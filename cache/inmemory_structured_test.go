@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+func TestInMemoryCacheGetIntoRoundTrip(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if err := c.Set(ctx, "n", 42, time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	var n int
+	if err := c.GetInto(ctx, "n", &n); err != nil {
+		t.Fatalf("GetInto: %v", err)
+	}
+	if n != 42 {
+		t.Fatalf("GetInto: got %d, want 42", n)
+	}
+}
+
+func TestInMemoryCacheGetBytesRoundTrip(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if err := c.Set(ctx, "s", "hello", time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	data, err := c.GetBytes(ctx, "s")
+	if err != nil {
+		t.Fatalf("GetBytes: %v", err)
+	}
+
+	var got string
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&got); err != nil {
+		t.Fatalf("decoding GetBytes output into concrete type: %v", err)
+	}
+	if got != "hello" {
+		t.Fatalf("decoded %q, want %q", got, "hello")
+	}
+}
+
+func TestInMemoryCacheSet(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if err := c.AddToSet(ctx, "set", "a", "b"); err != nil {
+		t.Fatalf("AddToSet: %v", err)
+	}
+
+	isMember, err := c.IsInSet(ctx, "set", "a")
+	if err != nil || !isMember {
+		t.Fatalf("IsInSet(a) = %v, %v; want true, nil", isMember, err)
+	}
+
+	if err := c.DeleteFromSet(ctx, "set", "a"); err != nil {
+		t.Fatalf("DeleteFromSet: %v", err)
+	}
+	if isMember, err := c.IsInSet(ctx, "set", "a"); err != nil || isMember {
+		t.Fatalf("IsInSet(a) after delete = %v, %v; want false, nil", isMember, err)
+	}
+	if isMember, err := c.IsInSet(ctx, "set", "b"); err != nil || !isMember {
+		t.Fatalf("IsInSet(b) = %v, %v; want true, nil", isMember, err)
+	}
+}
+
+func TestInMemoryCacheHash(t *testing.T) {
+	c := NewInMemoryCache(10, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	if err := c.SetToHash(ctx, "h", "field", "value"); err != nil {
+		t.Fatalf("SetToHash: %v", err)
+	}
+
+	got, err := c.GetFromHash(ctx, "h", "field")
+	if err != nil {
+		t.Fatalf("GetFromHash: %v", err)
+	}
+	if got != "value" {
+		t.Fatalf("GetFromHash = %v, want %q", got, "value")
+	}
+
+	if err := c.DeleteFromHash(ctx, "h", "field"); err != nil {
+		t.Fatalf("DeleteFromHash: %v", err)
+	}
+	if _, err := c.GetFromHash(ctx, "h", "field"); err != ErrKeyNotFound {
+		t.Fatalf("GetFromHash after delete = %v, want ErrKeyNotFound", err)
+	}
+}
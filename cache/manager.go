@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheManager shares a single backing Cache across multiple subsystems by
+// handing each one a namespaced view: keys are transparently prefixed
+// (e.g. "mempool/foo") so two namespaces don't collide, even though they
+// share one Redis/in-memory backend. Namespace names containing "/" can
+// collide (namespace "a" key "b/c" and namespace "a/b" key "c" are the
+// same backend key).
+type CacheManager struct {
+	backend Cache
+
+	mu         sync.Mutex
+	namespaces map[string]*namespacedCache
+}
+
+// NewCacheManager creates a CacheManager backed by the given Cache.
+func NewCacheManager(backend Cache) *CacheManager {
+	return &CacheManager{
+		backend:    backend,
+		namespaces: make(map[string]*namespacedCache),
+	}
+}
+
+// Cache returns the namespaced view for namespace, creating it on first use.
+func (m *CacheManager) Cache(namespace string) Cache {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ns, exists := m.namespaces[namespace]
+	if !exists {
+		ns = &namespacedCache{manager: m, namespace: namespace}
+		m.namespaces[namespace] = ns
+	}
+	return ns
+}
+
+// namespacedCache is a Cache view that prefixes every key with its namespace.
+type namespacedCache struct {
+	manager   *CacheManager
+	namespace string
+}
+
+func (n *namespacedCache) prefixed(key string) string {
+	return n.namespace + "/" + key
+}
+
+func (n *namespacedCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return n.manager.backend.Set(ctx, n.prefixed(key), value, ttl)
+}
+
+func (n *namespacedCache) Get(ctx context.Context, key string) (interface{}, error) {
+	return n.manager.backend.Get(ctx, n.prefixed(key))
+}
+
+func (n *namespacedCache) Delete(ctx context.Context, key string) error {
+	return n.manager.backend.Delete(ctx, n.prefixed(key))
+}
+
+func (n *namespacedCache) AsyncSet(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	n.manager.backend.AsyncSet(ctx, n.prefixed(key), value, ttl)
+}
+
+func (n *namespacedCache) AsyncDelete(ctx context.Context, key string) {
+	n.manager.backend.AsyncDelete(ctx, n.prefixed(key))
+}
+
+func (n *namespacedCache) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	return n.manager.backend.GetBytes(ctx, n.prefixed(key))
+}
+
+func (n *namespacedCache) GetInto(ctx context.Context, key string, dst interface{}) error {
+	return n.manager.backend.GetInto(ctx, n.prefixed(key), dst)
+}
+
+func (n *namespacedCache) AddToSet(ctx context.Context, key string, members ...string) error {
+	return n.manager.backend.AddToSet(ctx, n.prefixed(key), members...)
+}
+
+func (n *namespacedCache) IsInSet(ctx context.Context, key string, member string) (bool, error) {
+	return n.manager.backend.IsInSet(ctx, n.prefixed(key), member)
+}
+
+func (n *namespacedCache) DeleteFromSet(ctx context.Context, key string, members ...string) error {
+	return n.manager.backend.DeleteFromSet(ctx, n.prefixed(key), members...)
+}
+
+func (n *namespacedCache) GetFromHash(ctx context.Context, key string, field string) (interface{}, error) {
+	return n.manager.backend.GetFromHash(ctx, n.prefixed(key), field)
+}
+
+func (n *namespacedCache) SetToHash(ctx context.Context, key string, field string, value interface{}) error {
+	return n.manager.backend.SetToHash(ctx, n.prefixed(key), field, value)
+}
+
+func (n *namespacedCache) DeleteFromHash(ctx context.Context, key string, fields ...string) error {
+	return n.manager.backend.DeleteFromHash(ctx, n.prefixed(key), fields...)
+}
+
+func (n *namespacedCache) Lock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	return n.manager.backend.Lock(ctx, n.prefixed(key), ttl)
+}
+
+func (n *namespacedCache) TryLock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	return n.manager.backend.TryLock(ctx, n.prefixed(key), ttl)
+}
+
+func (n *namespacedCache) ReleaseLock(ctx context.Context, key, token string) error {
+	return n.manager.backend.ReleaseLock(ctx, n.prefixed(key), token)
+}
+
+// Keys lists the un-prefixed keys currently stored in this namespace.
+// It only works against backends that support enumeration (see Keyser);
+// other backends return ErrUnsupported.
+func (n *namespacedCache) Keys() ([]string, error) {
+	keyser, ok := n.manager.backend.(Keyser)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	prefix := n.namespace + "/"
+	all, err := keyser.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(all))
+	for _, k := range all {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, strings.TrimPrefix(k, prefix))
+		}
+	}
+	return keys, nil
+}
+
+// ClearNamespace deletes every key belonging to this namespace.
+func (n *namespacedCache) ClearNamespace(ctx context.Context) error {
+	keys, err := n.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := n.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
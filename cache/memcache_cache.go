@@ -0,0 +1,255 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// MemcacheCache implements Cache using memcached as the backing store.
+type MemcacheCache struct {
+	client *memcache.Client
+}
+
+// NewMemcacheCache creates a new MemcacheCache instance for the given
+// memcached servers.
+func NewMemcacheCache(servers ...string) *MemcacheCache {
+	return &MemcacheCache{client: memcache.New(servers...)}
+}
+
+// Set adds or updates a key-value pair in memcached with the specified TTL.
+func (c *MemcacheCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := encodeMemcacheValue(value)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(&memcache.Item{
+		Key:        key,
+		Value:      data,
+		Expiration: int32(ttl.Seconds()),
+	})
+}
+
+// Get retrieves the value associated with the given key from memcached.
+func (c *MemcacheCache) Get(ctx context.Context, key string) (interface{}, error) {
+	item, err := c.client.Get(key)
+	if err == memcache.ErrCacheMiss {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := gob.NewDecoder(bytes.NewReader(item.Value)).Decode(&value); err != nil {
+		// encodeMemcacheValue stores values gob can't handle as JSON.
+		if jsonErr := json.Unmarshal(item.Value, &value); jsonErr != nil {
+			return nil, fmt.Errorf("cache: failed to decode memcache value: %w", err)
+		}
+	}
+	return value, nil
+}
+
+// Delete removes the entry associated with the given key from memcached.
+func (c *MemcacheCache) Delete(ctx context.Context, key string) error {
+	err := c.client.Delete(key)
+	if err == memcache.ErrCacheMiss {
+		return ErrKeyNotFound
+	}
+	return err
+}
+
+// AsyncSet asynchronously sets a key-value pair in memcached.
+func (c *MemcacheCache) AsyncSet(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	go func() {
+		_ = c.Set(ctx, key, value, ttl)
+	}()
+}
+
+// AsyncDelete asynchronously deletes a key from memcached.
+func (c *MemcacheCache) AsyncDelete(ctx context.Context, key string) {
+	go func() {
+		_ = c.Delete(ctx, key)
+	}()
+}
+
+// GetBytes returns the raw bytes stored at key.
+func (c *MemcacheCache) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	item, err := c.client.Get(key)
+	if err == memcache.ErrCacheMiss {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return item.Value, nil
+}
+
+// GetInto decodes the value stored at key into dst.
+func (c *MemcacheCache) GetInto(ctx context.Context, key string, dst interface{}) error {
+	data, err := c.GetBytes(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, dst); err == nil {
+		return nil
+	}
+	// Set gob-encodes the value as an interface, so decode it as one and
+	// then convert it into dst's concrete type.
+	var value interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value); err != nil {
+		return err
+	}
+	return gobAssign(dst, value)
+}
+
+// memcached has no native set/hash primitives; these report ErrUnsupported
+// rather than faking the semantics on top of plain key-value storage.
+
+// AddToSet is unsupported by the memcache adapter.
+func (c *MemcacheCache) AddToSet(ctx context.Context, key string, members ...string) error {
+	return ErrUnsupported
+}
+
+// IsInSet is unsupported by the memcache adapter.
+func (c *MemcacheCache) IsInSet(ctx context.Context, key string, member string) (bool, error) {
+	return false, ErrUnsupported
+}
+
+// DeleteFromSet is unsupported by the memcache adapter.
+func (c *MemcacheCache) DeleteFromSet(ctx context.Context, key string, members ...string) error {
+	return ErrUnsupported
+}
+
+// GetFromHash is unsupported by the memcache adapter.
+func (c *MemcacheCache) GetFromHash(ctx context.Context, key string, field string) (interface{}, error) {
+	return nil, ErrUnsupported
+}
+
+// SetToHash is unsupported by the memcache adapter.
+func (c *MemcacheCache) SetToHash(ctx context.Context, key string, field string, value interface{}) error {
+	return ErrUnsupported
+}
+
+// DeleteFromHash is unsupported by the memcache adapter.
+func (c *MemcacheCache) DeleteFromHash(ctx context.Context, key string, fields ...string) error {
+	return ErrUnsupported
+}
+
+// Lock blocks until it acquires the memcache lock named key, or ctx is done.
+func (c *MemcacheCache) Lock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	const retryInterval = 50 * time.Millisecond
+
+	for {
+		lock, err := c.TryLock(ctx, key, ttl)
+		if err == nil {
+			return lock, nil
+		}
+		if err != ErrLockHeld {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// TryLock attempts to acquire the memcache lock named key without blocking,
+// using Add (which fails if the key already exists) to get NX semantics.
+func (c *MemcacheCache) TryLock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.client.Add(&memcache.Item{
+		Key:        key,
+		Value:      []byte(token),
+		Expiration: int32(ttl.Seconds()),
+	})
+	if err == memcache.ErrNotStored {
+		return nil, ErrLockHeld
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &memcacheLock{client: c.client, key: key, token: token}, nil
+}
+
+// memcacheLock is the Unlocker returned by MemcacheCache.Lock/TryLock.
+type memcacheLock struct {
+	client *memcache.Client
+	key    string
+	token  string
+}
+
+// Unlock releases the lock, but only if it's still held by this token.
+func (l *memcacheLock) Unlock(ctx context.Context) error {
+	return releaseMemcacheLock(l.client, l.key, l.token)
+}
+
+// Token returns the token identifying this held lock.
+func (l *memcacheLock) Token() string {
+	return l.token
+}
+
+// ReleaseLock releases the memcache lock named key if it is still held by
+// token, independent of which memcacheLock (if any) originally acquired it.
+func (c *MemcacheCache) ReleaseLock(ctx context.Context, key, token string) error {
+	return releaseMemcacheLock(c.client, key, token)
+}
+
+func releaseMemcacheLock(client *memcache.Client, key, token string) error {
+	item, err := client.Get(key)
+	if err == memcache.ErrCacheMiss {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if string(item.Value) != token {
+		return nil
+	}
+	return client.Delete(key)
+}
+
+// encodeMemcacheValue gob-encodes value, falling back to JSON for types gob
+// can't handle (e.g. values containing interfaces without registered types).
+func encodeMemcacheValue(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&value); err != nil {
+		data, jsonErr := json.Marshal(value)
+		if jsonErr != nil {
+			return nil, fmt.Errorf("cache: failed to encode memcache value: %w", err)
+		}
+		return data, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// memcacheConfig configures the "memcache" adapter.
+type memcacheConfig struct {
+	Servers []string `json:"servers"`
+}
+
+func newMemcacheAdapter(jsonCfg string) (Adapter, error) {
+	var cfg memcacheConfig
+	if jsonCfg != "" {
+		if err := json.Unmarshal([]byte(jsonCfg), &cfg); err != nil {
+			return nil, fmt.Errorf("cache: invalid memcache config: %w", err)
+		}
+	}
+	if len(cfg.Servers) == 0 {
+		return nil, fmt.Errorf("cache: memcache config requires at least one server")
+	}
+	return NewMemcacheCache(cfg.Servers...), nil
+}
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisConfig configures a RedisCache for standalone, Sentinel, or Cluster
+// deployments. Which mode is used is picked from which address field is
+// set: MasterName (+ SentinelAddrs) => Sentinel, ClusterAddrs => Cluster,
+// otherwise Addrs is dialed as a single standalone client.
+type RedisConfig struct {
+	// Addrs is the server address for a standalone deployment, e.g.
+	// []string{"localhost:6379"}.
+	Addrs []string `json:"addrs"`
+
+	// MasterName, SentinelAddrs, and SentinelPassword configure a
+	// Sentinel deployment: the sentinel nodes at SentinelAddrs are asked
+	// to report the current master named MasterName.
+	MasterName       string   `json:"masterName"`
+	SentinelAddrs    []string `json:"sentinelAddrs"`
+	SentinelPassword string   `json:"sentinelPassword"`
+
+	// ClusterAddrs configures a Cluster deployment: the seed node addresses.
+	ClusterAddrs []string `json:"clusterAddrs"`
+
+	Password string `json:"password"`
+	DB       int    `json:"db"`
+
+	TLS *RedisTLSConfig `json:"tls"`
+
+	PoolSize     int `json:"poolSize"`
+	MinIdleConns int `json:"minIdleConns"`
+	// DialTimeoutSecs is an integer number of seconds rather than a
+	// time.Duration: JSON has no duration type, so a time.Duration field
+	// silently parses "dialTimeout": 5 as 5 nanoseconds instead of 5
+	// seconds.
+	DialTimeoutSecs int `json:"dialTimeoutSeconds"`
+}
+
+// RedisTLSConfig configures TLS for connections to Redis.
+type RedisTLSConfig struct {
+	RootCAFile         string `json:"rootCAFile"`
+	CertFile           string `json:"certFile"`
+	KeyFile            string `json:"keyFile"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+}
+
+// NewRedisCacheFromConfig builds a RedisCache backed by whichever
+// redis.UniversalClient implementation matches cfg (simple, Sentinel-aware
+// failover, or cluster), so deployments can survive master failover or
+// scale to a cluster without any call-site changes.
+func NewRedisCacheFromConfig(cfg RedisConfig) (*RedisCache, error) {
+	addrs := cfg.Addrs
+	switch {
+	case cfg.MasterName != "":
+		addrs = cfg.SentinelAddrs
+	case len(cfg.ClusterAddrs) > 0:
+		addrs = cfg.ClusterAddrs
+	}
+
+	opts := &redis.UniversalOptions{
+		Addrs:            addrs,
+		MasterName:       cfg.MasterName,
+		SentinelPassword: cfg.SentinelPassword,
+		Password:         cfg.Password,
+		DB:               cfg.DB,
+		PoolSize:         cfg.PoolSize,
+		MinIdleConns:     cfg.MinIdleConns,
+		DialTimeout:      time.Duration(cfg.DialTimeoutSecs) * time.Second,
+	}
+
+	if cfg.TLS != nil {
+		tlsConfig, err := buildRedisTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts.TLSConfig = tlsConfig
+	}
+
+	// The client type is chosen from cfg's fields directly rather than via
+	// redis.NewUniversalClient, which picks by address count and would
+	// treat a single cluster seed as a standalone server.
+	var client redis.UniversalClient
+	switch {
+	case cfg.MasterName != "":
+		client = redis.NewFailoverClient(opts.Failover())
+	case len(cfg.ClusterAddrs) > 0:
+		client = redis.NewClusterClient(opts.Cluster())
+	default:
+		client = redis.NewClient(opts.Simple())
+	}
+	return NewRedisCache(client), nil
+}
+
+func buildRedisTLSConfig(cfg *RedisTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+
+	if cfg.RootCAFile != "" {
+		pem, err := os.ReadFile(cfg.RootCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("cache: failed to read redis root CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("cache: no certificates found in %s", cfg.RootCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cache: failed to load redis client cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
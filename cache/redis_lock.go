@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// unlockScript deletes the lock key only if it still holds the token we
+// set, so a caller can never release a lock it no longer owns (e.g. after
+// its TTL already expired and someone else acquired it).
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+else
+	return 0
+end
+`)
+
+// renewScript extends the lock's TTL only if it still holds our token.
+var renewScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+else
+	return 0
+end
+`)
+
+// redisLock is the Unlocker returned by RedisCache.Lock/TryLock. While held,
+// a watchdog goroutine periodically renews the TTL so a slow caller doesn't
+// lose the lock mid-operation; Unlock stops the watchdog before releasing.
+type redisLock struct {
+	client redis.UniversalClient
+	key    string
+	token  string
+	ttl    time.Duration
+	stop   chan struct{}
+}
+
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Lock blocks until it acquires the Redis lock named key, or ctx is done.
+func (c *RedisCache) Lock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	const retryInterval = 50 * time.Millisecond
+
+	for {
+		lock, err := c.TryLock(ctx, key, ttl)
+		if err == nil {
+			return lock, nil
+		}
+		if err != ErrLockHeld {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// TryLock attempts to acquire the Redis lock named key without blocking,
+// using SET key token NX PX ttl.
+func (c *RedisCache) TryLock(ctx context.Context, key string, ttl time.Duration) (Unlocker, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := c.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLockHeld
+	}
+
+	lock := &redisLock{client: c.client, key: key, token: token, ttl: ttl, stop: make(chan struct{})}
+	go lock.watchdog()
+	return lock, nil
+}
+
+// minWatchdogInterval floors the renewal ticker so a caller-supplied ttl
+// too small to halve into a positive duration (including ttl <= 0) can't
+// make time.NewTicker panic.
+const minWatchdogInterval = time.Millisecond
+
+// watchdog renews the lock's TTL at half its duration until Unlock is called.
+func (l *redisLock) watchdog() {
+	interval := l.ttl / 2
+	if interval < minWatchdogInterval {
+		interval = minWatchdogInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), l.ttl)
+			renewScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds())
+			cancel()
+		}
+	}
+}
+
+// Unlock stops the watchdog and releases the lock, but only if it's still
+// held by this token.
+func (l *redisLock) Unlock(ctx context.Context) error {
+	close(l.stop)
+	return unlockScript.Run(ctx, l.client, []string{l.key}, l.token).Err()
+}
+
+// Token returns the token identifying this held lock.
+func (l *redisLock) Token() string {
+	return l.token
+}
+
+// ReleaseLock releases the Redis lock named key if it is still held by
+// token. Unlike Unlock, it has no watchdog goroutine to stop, so it works
+// whether or not this process is the one that acquired the lock.
+func (c *RedisCache) ReleaseLock(ctx context.Context, key, token string) error {
+	return unlockScript.Run(ctx, c.client, []string{key}, token).Err()
+}
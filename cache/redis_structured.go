@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// GetBytes returns the raw bytes stored at key.
+func (c *RedisCache) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	return data, err
+}
+
+// GetInto JSON-decodes the value stored at key into dst.
+func (c *RedisCache) GetInto(ctx context.Context, key string, dst interface{}) error {
+	data, err := c.GetBytes(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("cache: failed to decode value: %w", err)
+	}
+	return nil
+}
+
+// AddToSet adds members to the Redis set stored at key.
+func (c *RedisCache) AddToSet(ctx context.Context, key string, members ...string) error {
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return c.client.SAdd(ctx, key, args...).Err()
+}
+
+// IsInSet reports whether member belongs to the Redis set stored at key.
+func (c *RedisCache) IsInSet(ctx context.Context, key string, member string) (bool, error) {
+	return c.client.SIsMember(ctx, key, member).Result()
+}
+
+// DeleteFromSet removes members from the Redis set stored at key.
+func (c *RedisCache) DeleteFromSet(ctx context.Context, key string, members ...string) error {
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return c.client.SRem(ctx, key, args...).Err()
+}
+
+// GetFromHash returns the value of field in the Redis hash stored at key.
+func (c *RedisCache) GetFromHash(ctx context.Context, key string, field string) (interface{}, error) {
+	value, err := c.client.HGet(ctx, key, field).Result()
+	if err == redis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	return value, err
+}
+
+// SetToHash sets field to value in the Redis hash stored at key.
+func (c *RedisCache) SetToHash(ctx context.Context, key string, field string, value interface{}) error {
+	return c.client.HSet(ctx, key, field, value).Err()
+}
+
+// DeleteFromHash removes fields from the Redis hash stored at key.
+func (c *RedisCache) DeleteFromHash(ctx context.Context, key string, fields ...string) error {
+	return c.client.HDel(ctx, key, fields...).Err()
+}
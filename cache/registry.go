@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Adapter is the interface every registered cache backend must satisfy.
+// It is currently identical to Cache; it exists as a separate name so
+// backends can be registered/looked up without implying they are the
+// only Cache implementation in the program.
+type Adapter interface {
+	Cache
+}
+
+// Factory builds an Adapter from a JSON configuration blob. The blob's
+// shape is adapter-specific (see memoryConfig, RedisConfig, memcacheConfig).
+type Factory func(jsonCfg string) (Adapter, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Factory{}
+)
+
+// Register adds a named factory to the adapter registry. It panics if the
+// name is already registered, mirroring how database/sql's driver registry
+// guards against accidental double-registration.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("cache: Register called twice for adapter %q", name))
+	}
+	registry[name] = factory
+}
+
+// NewCache constructs a Cache from the named adapter, configuring it from
+// jsonCfg. Call sites no longer need to know which concrete type backs a
+// given deployment, e.g.:
+//
+//	c, err := cache.NewCache("redis", `{"addrs":["localhost:6379"]}`)
+func NewCache(name, jsonCfg string) (Cache, error) {
+	registryMu.RLock()
+	factory, exists := registry[name]
+	registryMu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("cache: unknown adapter %q", name)
+	}
+	return factory(jsonCfg)
+}
+
+func init() {
+	Register("memory", newMemoryAdapter)
+	Register("redis", newRedisAdapter)
+	Register("memcache", newMemcacheAdapter)
+	Register("sentinel", newSentinelAdapter)
+	Register("cluster", newClusterAdapter)
+}
+
+// memoryConfig configures the "memory" adapter.
+type memoryConfig struct {
+	MaxSize        int `json:"maxSize"`
+	GCIntervalSecs int `json:"gcIntervalSeconds"`
+}
+
+func newMemoryAdapter(jsonCfg string) (Adapter, error) {
+	cfg := memoryConfig{MaxSize: 1000, GCIntervalSecs: 60}
+	if jsonCfg != "" {
+		if err := json.Unmarshal([]byte(jsonCfg), &cfg); err != nil {
+			return nil, fmt.Errorf("cache: invalid memory config: %w", err)
+		}
+	}
+	if cfg.MaxSize <= 0 {
+		return nil, fmt.Errorf("cache: memory config: maxSize must be > 0")
+	}
+	return NewInMemoryCache(cfg.MaxSize, time.Duration(cfg.GCIntervalSecs)*time.Second), nil
+}
+
+// newRedisAdapter configures the "redis" adapter for a standalone instance.
+// It accepts the same RedisConfig shape as the sentinel/cluster adapters so
+// a deployment can be promoted to Sentinel/Cluster later by switching the
+// adapter name and filling in the relevant fields.
+func newRedisAdapter(jsonCfg string) (Adapter, error) {
+	cfg := RedisConfig{Addrs: []string{"localhost:6379"}}
+	if jsonCfg != "" {
+		if err := json.Unmarshal([]byte(jsonCfg), &cfg); err != nil {
+			return nil, fmt.Errorf("cache: invalid redis config: %w", err)
+		}
+	}
+	return NewRedisCacheFromConfig(cfg)
+}
+
+// newSentinelAdapter configures the "sentinel" adapter (Redis Sentinel).
+func newSentinelAdapter(jsonCfg string) (Adapter, error) {
+	var cfg RedisConfig
+	if jsonCfg != "" {
+		if err := json.Unmarshal([]byte(jsonCfg), &cfg); err != nil {
+			return nil, fmt.Errorf("cache: invalid sentinel config: %w", err)
+		}
+	}
+	if cfg.MasterName == "" || len(cfg.SentinelAddrs) == 0 {
+		return nil, fmt.Errorf("cache: sentinel config requires masterName and sentinelAddrs")
+	}
+	return NewRedisCacheFromConfig(cfg)
+}
+
+// newClusterAdapter configures the "cluster" adapter (Redis Cluster).
+func newClusterAdapter(jsonCfg string) (Adapter, error) {
+	var cfg RedisConfig
+	if jsonCfg != "" {
+		if err := json.Unmarshal([]byte(jsonCfg), &cfg); err != nil {
+			return nil, fmt.Errorf("cache: invalid cluster config: %w", err)
+		}
+	}
+	if len(cfg.ClusterAddrs) == 0 {
+		return nil, fmt.Errorf("cache: cluster config requires clusterAddrs")
+	}
+	return NewRedisCacheFromConfig(cfg)
+}
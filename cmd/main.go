@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Devisree146/go-cache-api/cache" // Update with your GitHub username and repo name
+	"github.com/gin-gonic/gin"
+)
+
+// cacheEntry is the JSON body accepted by POST /cache/:ns.
+type cacheEntry struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+	TTL   int64       `json:"ttl"` // seconds
+}
+
+// defaultLockTTL bounds how long a lock is held if its owner never calls
+// DELETE /lock/:key/:token (e.g. it crashed).
+const defaultLockTTL = 30 * time.Second
+
+var manager *cache.CacheManager
+
+// outstandingLocks maps a lock's backend token to the Unlocker that
+// acquired it, purely so releasing it on the same replica that acquired
+// it can stop that Unlocker's bookkeeping (e.g. the Redis watchdog
+// goroutine) instead of leaving it running until its ttl lapses. The
+// token itself (returned to HTTP callers) is backend state, not this
+// map — handleLockRelease falls back to Cache.ReleaseLock when a token
+// isn't found here, which is what lets a lock acquired on one replica be
+// released via a request that lands on another.
+var (
+	locksMu          sync.Mutex
+	outstandingLocks = make(map[string]cache.Unlocker)
+)
+
+func main() {
+	backend, err := newConfiguredCache()
+	if err != nil {
+		panic(err)
+	}
+	manager = cache.NewCacheManager(backend)
+
+	r := gin.Default()
+
+	r.POST("/cache/:ns", handleCachePost)
+	r.GET("/cache/:ns/:key", handleCacheGet)
+	r.DELETE("/cache/:ns/:key", handleCacheDelete)
+
+	r.POST("/lock/:key", handleLockAcquire)
+	r.DELETE("/lock/:key/:token", handleLockRelease)
+
+	r.Run(":8080")
+}
+
+// newConfiguredCache builds the Cache backend named by CACHE_ADAPTER
+// (defaulting to "memory"), configured by the JSON blob in CACHE_CONFIG.
+// This lets deployments swap backends (memory/redis/memcache/sentinel)
+// without touching any call site below.
+func newConfiguredCache() (cache.Cache, error) {
+	adapter := os.Getenv("CACHE_ADAPTER")
+	if adapter == "" {
+		adapter = "memory"
+	}
+	return cache.NewCache(adapter, os.Getenv("CACHE_CONFIG"))
+}
+
+func handleCachePost(c *gin.Context) {
+	var entry cacheEntry
+	if err := c.BindJSON(&entry); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to decode JSON"})
+		return
+	}
+
+	ctx := context.Background()
+	ns := manager.Cache(c.Param("ns"))
+	if err := ns.Set(ctx, entry.Key, entry.Value, time.Duration(entry.TTL)*time.Second); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set cache"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Key set successfully", "key": entry.Key})
+}
+
+func handleCacheGet(c *gin.Context) {
+	key := c.Param("key")
+	ctx := context.Background()
+	ns := manager.Cache(c.Param("ns"))
+	value, err := ns.Get(ctx, key)
+	if err == cache.ErrKeyNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Key not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get cache"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"key": key, "value": value})
+}
+
+func handleCacheDelete(c *gin.Context) {
+	key := c.Param("key")
+	ctx := context.Background()
+	ns := manager.Cache(c.Param("ns"))
+	if err := ns.Delete(ctx, key); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete cache"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Key deleted successfully", "key": key})
+}
+
+// handleLockAcquire tries to acquire the named lock without blocking and
+// returns a token the caller must present to release it.
+func handleLockAcquire(c *gin.Context) {
+	key := c.Param("key")
+	ctx := context.Background()
+
+	lock, err := manager.Cache("locks").TryLock(ctx, key, defaultLockTTL)
+	if err == cache.ErrLockHeld {
+		c.JSON(http.StatusConflict, gin.H{"error": "Lock already held"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to acquire lock"})
+		return
+	}
+
+	token := lock.Token()
+
+	locksMu.Lock()
+	outstandingLocks[token] = lock
+	locksMu.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"key": key, "token": token})
+}
+
+// handleLockRelease releases a lock previously acquired via
+// handleLockAcquire, identified by the key and the (backend) token it
+// returned. If this replica is the one that acquired the lock, it
+// releases it through the original Unlocker so any associated
+// bookkeeping (e.g. Redis's renewal watchdog) stops too; otherwise it
+// falls back to releasing directly against the backend by key+token.
+func handleLockRelease(c *gin.Context) {
+	key := c.Param("key")
+	token := c.Param("token")
+
+	locksMu.Lock()
+	lock, exists := outstandingLocks[token]
+	if exists {
+		delete(outstandingLocks, token)
+	}
+	locksMu.Unlock()
+
+	var err error
+	if exists {
+		err = lock.Unlock(context.Background())
+	} else {
+		err = manager.Cache("locks").ReleaseLock(context.Background(), key, token)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to release lock"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Lock released", "key": key})
+}